@@ -69,6 +69,7 @@ require (
 	github.com/hashicorp/golang-lru/v2 v2.0.7 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/liggitt/tabwriter v0.0.0-20181228230101-89fcab3d43de // indirect
 	github.com/mackerelio/go-osstat v0.2.6 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
@@ -161,3 +162,7 @@ replace sigs.k8s.io/controller-tools => github.com/cilium/controller-tools v0.16
 // Using private fork of gobgp. See commit msg for more context as to why we
 // are using a private fork.
 replace github.com/osrg/gobgp/v3 => github.com/cilium/gobgp/v3 v3.0.0-20260130142103-27e5da2a39e6
+
+// The Hubble CLI changes pending upstream, applied by "make cilium-src", see
+// patches/cilium/README.md.
+replace github.com/cilium/cilium => ./third_party/cilium